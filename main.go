@@ -4,34 +4,26 @@ import (
 	"errors"
 	"fmt"
 
+	"boat"
 	"github.com/manifoldco/promptui"
-    "boat"
 )
 
 func main() {
-	var px boat.Rule
-
-	validateRule := func(input string) error {
-		parsed, err := boat.ParseRule(input)
-		if err != nil {
-			return errors.New("Invalid request")
-		}
-		px = parsed
-		_ = px
-		return nil
-	}
+	var px *boat.Program
 
 	validateInput := func(input string) error {
 		pass, err := px.Eval(input)
-		if pass != true || err != nil {
+		if err != nil {
+			return err
+		}
+		if pass != true {
 			return errors.New("Invalid request")
 		}
 		return nil
 	}
 
 	getrule := promptui.Prompt{
-		Label:    "rule",
-		Validate: validateRule,
+		Label: "rule",
 	}
 
 	getinput := promptui.Prompt{
@@ -39,14 +31,19 @@ func main() {
 		Validate: validateInput,
 	}
 
-	resultRule, err := getrule.Run()
+	for px == nil {
+		resultRule, err := getrule.Run()
 
-	if err != nil {
-		fmt.Printf("Rule failed %v\n", err)
-		return
-	}
+		if err != nil {
+			fmt.Printf("Rule failed %v\n", err)
+			return
+		}
 
-	_ = resultRule
+		px, err = boat.ParseRule(resultRule)
+		if err != nil {
+			fmt.Printf("%s\n\n", boat.Diagnose(resultRule, err))
+		}
+	}
 
 	resultInput, err := getinput.Run()
 
@@ -56,4 +53,4 @@ func main() {
 	}
 
 	fmt.Printf("You choose %q\n", resultInput)
-}
\ No newline at end of file
+}